@@ -0,0 +1,157 @@
+package main
+
+import (
+	"crypto/subtle"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/zer0db/cnc-nuke-reactor/reactor"
+)
+
+// appConfig is the server's layered configuration: command-line flags and
+// environment variables seed the structural fields (listen address, tick
+// interval, config file path, bearer token), and reactor.yaml supplies the
+// reactor's tunable physics constants. Precedence, low to high: built-in
+// defaults, environment variables, reactor.yaml, command-line flags.
+type appConfig struct {
+	Listen      string
+	Tick        time.Duration
+	ConfigPath  string
+	BearerToken string
+	Reactor     reactor.Config
+}
+
+// configFile is the shape of the optional reactor.yaml: a handful of server
+// settings alongside the reactor tunables.
+type configFile struct {
+	Listen      string         `yaml:"listen"`
+	Tick        time.Duration  `yaml:"tick"`
+	BearerToken string         `yaml:"bearerToken"`
+	Reactor     reactor.Config `yaml:"reactor"`
+}
+
+func defaultAppConfig() appConfig {
+	return appConfig{
+		Listen:     ":80",
+		Tick:       50 * time.Millisecond,
+		ConfigPath: "reactor.yaml",
+		Reactor:    reactor.DefaultConfig(),
+	}
+}
+
+// loadAppConfig resolves defaults, then environment variables, then
+// reactor.yaml (whose path can itself come from -config/CONFIG_FILE), then
+// command-line flags, which win ties.
+func loadAppConfig() (appConfig, error) {
+	cfg := defaultAppConfig()
+
+	if v := os.Getenv("LISTEN_ADDR"); v != "" {
+		cfg.Listen = v
+	}
+	if v := os.Getenv("TICK_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("TICK_INTERVAL: %w", err)
+		}
+		cfg.Tick = d
+	}
+	if v := os.Getenv("CONFIG_FILE"); v != "" {
+		cfg.ConfigPath = v
+	}
+	if v := os.Getenv("BEARER_TOKEN"); v != "" {
+		cfg.BearerToken = v
+	}
+
+	listen := flag.String("listen", cfg.Listen, "address to listen on, e.g. :8080")
+	tick := flag.Duration("tick", cfg.Tick, "simulation tick interval")
+	configPath := flag.String("config", cfg.ConfigPath, "path to reactor.yaml")
+	flag.Parse()
+	cfg.ConfigPath = *configPath
+
+	if err := cfg.loadFile(); err != nil {
+		return cfg, err
+	}
+
+	// Flags win over both env and the file for the fields they cover.
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "listen":
+			cfg.Listen = *listen
+		case "tick":
+			cfg.Tick = *tick
+		}
+	})
+
+	if err := cfg.validate(); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// loadFile (re-)reads reactor.yaml at cfg.ConfigPath, if present, merging
+// its fields onto cfg. It's also what a SIGHUP reload calls.
+func (cfg *appConfig) loadFile() error {
+	data, err := os.ReadFile(cfg.ConfigPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil // the config file is optional
+	}
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", cfg.ConfigPath, err)
+	}
+
+	file := configFile{Listen: cfg.Listen, Tick: cfg.Tick, BearerToken: cfg.BearerToken, Reactor: cfg.Reactor}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("parsing %s: %w", cfg.ConfigPath, err)
+	}
+	cfg.Listen = file.Listen
+	cfg.Tick = file.Tick
+	cfg.BearerToken = file.BearerToken
+	cfg.Reactor = file.Reactor
+	return nil
+}
+
+// validate rejects configuration that would crash or misbehave at startup
+// or on a SIGHUP reload, e.g. a non-positive tick interval panicking
+// time.NewTicker/Ticker.Reset.
+func (cfg *appConfig) validate() error {
+	if cfg.Tick <= 0 {
+		return fmt.Errorf("tick interval must be positive, got %s", cfg.Tick)
+	}
+	return nil
+}
+
+// authGuard gates /api/config behind an optional bearer token, which can
+// change on a SIGHUP reload, hence the mutex instead of a constant string.
+type authGuard struct {
+	mu    sync.RWMutex
+	token string
+}
+
+func (a *authGuard) set(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.token = token
+}
+
+// allow reports whether r is authorized: always true if no token is
+// configured, otherwise it must carry a matching "Authorization: Bearer
+// <token>" header. The comparison is constant-time so a client can't use
+// response timing to guess the token byte by byte.
+func (a *authGuard) allow(r *http.Request) bool {
+	a.mu.RLock()
+	token := a.token
+	a.mu.RUnlock()
+	if token == "" {
+		return true
+	}
+	got := r.Header.Get("Authorization")
+	want := "Bearer " + token
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
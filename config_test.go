@@ -0,0 +1,56 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAuthGuardAllowsEverythingWithNoTokenConfigured(t *testing.T) {
+	var a authGuard
+	req := newAuthRequest("")
+	if !a.allow(req) {
+		t.Fatal("expected no configured token to allow every request")
+	}
+}
+
+func TestAuthGuardRequiresMatchingBearerToken(t *testing.T) {
+	var a authGuard
+	a.set("s3cret")
+
+	if a.allow(newAuthRequest("")) {
+		t.Fatal("expected a missing Authorization header to be rejected")
+	}
+	if a.allow(newAuthRequest("Bearer wrong")) {
+		t.Fatal("expected a mismatched token to be rejected")
+	}
+	if !a.allow(newAuthRequest("Bearer s3cret")) {
+		t.Fatal("expected the matching token to be allowed")
+	}
+}
+
+func newAuthRequest(authHeader string) *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "/api/config", nil)
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	return req
+}
+
+func TestAppConfigValidateRejectsNonPositiveTick(t *testing.T) {
+	cfg := defaultAppConfig()
+	cfg.Tick = 0
+	if err := cfg.validate(); err == nil {
+		t.Fatal("expected a zero tick interval to be rejected")
+	}
+	cfg.Tick = -time.Second
+	if err := cfg.validate(); err == nil {
+		t.Fatal("expected a negative tick interval to be rejected")
+	}
+}
+
+func TestAppConfigValidateAcceptsDefaults(t *testing.T) {
+	if err := defaultAppConfig().validate(); err != nil {
+		t.Fatalf("expected defaultAppConfig to validate, got %v", err)
+	}
+}
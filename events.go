@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zer0db/cnc-nuke-reactor/reactor"
+)
+
+// eventLogCapacity bounds the in-memory ring buffer backing
+// /api/events/log; older entries are dropped once it fills.
+const eventLogCapacity = 500
+
+// statusEvent is one structured status-transition record, emitted whenever
+// a reactor's Status flags change.
+type statusEvent struct {
+	Seq       uint64    `json:"seq"`
+	Time      time.Time `json:"time"`
+	ReactorID string    `json:"reactorId"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Meltdown  bool      `json:"meltdown"`
+}
+
+// eventLog is a small ring buffer of statusEvents plus a plain-text sink
+// (stdout or a file), so operators can tail it live or replay recent
+// history via /api/events/log?since=<seq>.
+type eventLog struct {
+	mu      sync.Mutex
+	entries []statusEvent
+	nextSeq uint64
+	sink    io.Writer
+}
+
+// newEventLog builds an eventLog writing to the sink named by the
+// EVENT_LOG_SINK environment variable: "stdout" (default), "none", or
+// "file:<path>".
+func newEventLog() *eventLog {
+	sink := os.Getenv("EVENT_LOG_SINK")
+	var w io.Writer
+	switch {
+	case sink == "" || sink == "stdout":
+		w = os.Stdout
+	case sink == "none":
+		w = io.Discard
+	case strings.HasPrefix(sink, "file:"):
+		path := strings.TrimPrefix(sink, "file:")
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Printf("event log: could not open %s, falling back to stdout: %v", path, err)
+			w = os.Stdout
+		} else {
+			w = f
+		}
+	default:
+		log.Printf("event log: unrecognized EVENT_LOG_SINK %q, falling back to stdout", sink)
+		w = os.Stdout
+	}
+	return &eventLog{sink: w}
+}
+
+// record appends a transition, writes it to the configured sink as a JSON
+// line, and evicts the oldest entry if the ring buffer is full.
+func (l *eventLog) record(reactorID string, prev, next reactor.ReactorStatus) {
+	l.mu.Lock()
+	l.nextSeq++
+	ev := statusEvent{
+		Seq:       l.nextSeq,
+		Time:      time.Now(),
+		ReactorID: reactorID,
+		From:      prev.String(),
+		To:        next.String(),
+		Meltdown:  next&reactor.StatusMeltdown != 0 && prev&reactor.StatusMeltdown == 0,
+	}
+	l.entries = append(l.entries, ev)
+	if len(l.entries) > eventLogCapacity {
+		l.entries = l.entries[len(l.entries)-eventLogCapacity:]
+	}
+	l.mu.Unlock()
+
+	if j, err := json.Marshal(ev); err == nil {
+		_, _ = l.sink.Write(append(j, '\n'))
+	}
+}
+
+// since returns all recorded entries with Seq > seq, oldest first.
+func (l *eventLog) since(seq uint64) []statusEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	out := make([]statusEvent, 0, len(l.entries))
+	for _, ev := range l.entries {
+		if ev.Seq > seq {
+			out = append(out, ev)
+		}
+	}
+	return out
+}
+
+// handleEventsLog serves GET /api/events/log?since=<seq>, returning
+// transitions recorded after seq (default 0, i.e. everything buffered).
+func (l *eventLog) handleEventsLog(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	since := uint64(0)
+	if s := r.URL.Query().Get("since"); s != "" {
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			http.Error(w, "bad since parameter", http.StatusBadRequest)
+			return
+		}
+		since = v
+	}
+	j, _ := json.Marshal(l.since(since))
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(j)
+}
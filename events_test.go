@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/zer0db/cnc-nuke-reactor/reactor"
+)
+
+func newTestEventLog() (*eventLog, *bytes.Buffer) {
+	var buf bytes.Buffer
+	return &eventLog{sink: &buf}, &buf
+}
+
+func TestEventLogRecordAssignsIncreasingSeq(t *testing.T) {
+	l, _ := newTestEventLog()
+	l.record("1", reactor.StatusNone, reactor.StatusOverheat)
+	l.record("1", reactor.StatusOverheat, reactor.StatusNone)
+
+	entries := l.since(0)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Seq != 1 || entries[1].Seq != 2 {
+		t.Fatalf("expected sequential seq numbers 1, 2, got %d, %d", entries[0].Seq, entries[1].Seq)
+	}
+}
+
+func TestEventLogRecordFlagsMeltdownOnlyOnRisingEdge(t *testing.T) {
+	l, _ := newTestEventLog()
+	l.record("1", reactor.StatusOverheat, reactor.StatusMeltdown)
+	l.record("1", reactor.StatusMeltdown, reactor.StatusMeltdown|reactor.StatusOverheat)
+
+	entries := l.since(0)
+	if !entries[0].Meltdown {
+		t.Fatal("expected the transition into StatusMeltdown to be flagged")
+	}
+	if entries[1].Meltdown {
+		t.Fatal("expected a transition that was already in StatusMeltdown to not be flagged again")
+	}
+}
+
+func TestEventLogRecordEvictsOldestPastCapacity(t *testing.T) {
+	l, _ := newTestEventLog()
+	for i := 0; i < eventLogCapacity+10; i++ {
+		l.record("1", reactor.StatusNone, reactor.StatusOverheat)
+	}
+	entries := l.since(0)
+	if len(entries) != eventLogCapacity {
+		t.Fatalf("expected the ring buffer to cap at %d entries, got %d", eventLogCapacity, len(entries))
+	}
+	if entries[0].Seq != 11 {
+		t.Fatalf("expected the oldest 10 entries to be evicted, first remaining seq = %d", entries[0].Seq)
+	}
+}
+
+func TestEventLogSinceFiltersBySeq(t *testing.T) {
+	l, _ := newTestEventLog()
+	l.record("1", reactor.StatusNone, reactor.StatusOverheat)
+	l.record("1", reactor.StatusOverheat, reactor.StatusNone)
+	l.record("1", reactor.StatusNone, reactor.StatusOverheat)
+
+	entries := l.since(1)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries after seq 1, got %d", len(entries))
+	}
+	if entries[0].Seq != 2 || entries[1].Seq != 3 {
+		t.Fatalf("expected seq 2 and 3, got %d and %d", entries[0].Seq, entries[1].Seq)
+	}
+}
+
+func TestEventLogRecordWritesJSONLineToSink(t *testing.T) {
+	l, buf := newTestEventLog()
+	l.record("1", reactor.StatusNone, reactor.StatusOverheat)
+
+	var ev statusEvent
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &ev); err != nil {
+		t.Fatalf("expected a JSON line written to the sink, got %q: %v", buf.String(), err)
+	}
+	if ev.ReactorID != "1" || ev.To != reactor.StatusOverheat.String() {
+		t.Fatalf("unexpected event written to sink: %+v", ev)
+	}
+}
@@ -1,32 +1,243 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
+	"syscall"
 	"time"
 
+	"nhooyr.io/websocket"
+
 	"github.com/zer0db/cnc-nuke-reactor/reactor"
 )
 
+const (
+	wsSendQueueSize = 8
+	wsPingInterval  = 15 * time.Second
+	wsPongTimeout   = 10 * time.Second
+	wsMaxSubFields  = 32
+)
+
+// actionPayload mirrors the body accepted by /api/action, and is reused by
+// the /ws endpoint so both transports dispatch commands identically.
+type actionPayload struct {
+	Type  string  `json:"type"`
+	Value float64 `json:"value"`
+	Model string  `json:"model,omitempty"`
+}
+
+// applyAction dispatches a single command to the simulation backend. It is
+// shared by the HTTP action endpoint and the WebSocket command channel.
+func applyAction(sim *reactor.Reactor, p actionPayload) error {
+	switch p.Type {
+	case "powerOn":
+		sim.PowerOn()
+	case "powerOff":
+		sim.PowerOff()
+	case "scram":
+		sim.Scram()
+	case "toggleAuto":
+		sim.ToggleAuto()
+	case "refuel":
+		sim.Refuel()
+	case "setFissionRate":
+		sim.SetFissionRate(p.Value)
+	case "setTurbineOutput":
+		sim.SetTurbineOutput(p.Value)
+	case "setPowerLoad":
+		sim.SetPowerLoad(p.Value)
+	case "setModel":
+		return sim.SetModel(p.Model)
+	default:
+		return errors.New("unknown action")
+	}
+	return nil
+}
+
+// wsSubscribeMsg lets a client narrow the telemetry it receives down to a
+// subset of ReactorState fields and/or a minimum interval between updates.
+type wsSubscribeMsg struct {
+	Type          string   `json:"type"` // "subscribe"
+	Fields        []string `json:"fields,omitempty"`
+	MinIntervalMs int      `json:"minIntervalMs,omitempty"`
+}
+
+// wsClient tracks one connected /ws peer: its outbound send queue (for
+// backpressure) and its current subscription filter.
+type wsClient struct {
+	send chan []byte
+
+	// close tears down the underlying connection (cancels its context,
+	// removes it from wsClients, closes the socket). It is set by the /ws
+	// handler once the connection is established, so the tick loop's
+	// backpressure-overflow path can drop a client the same way the
+	// handler's own error paths do, instead of closing send directly.
+	close func(code websocket.StatusCode, reason string)
+
+	mu          sync.Mutex
+	fields      map[string]bool // nil/empty means "all fields"
+	minInterval time.Duration
+	lastSent    time.Time
+}
+
+func newWSClient() *wsClient {
+	return &wsClient{send: make(chan []byte, wsSendQueueSize)}
+}
+
+func (c *wsClient) setSubscription(msg wsSubscribeMsg) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(msg.Fields) == 0 {
+		c.fields = nil
+	} else {
+		fields := make(map[string]bool, len(msg.Fields))
+		for i, f := range msg.Fields {
+			if i >= wsMaxSubFields {
+				break
+			}
+			fields[f] = true
+		}
+		c.fields = fields
+	}
+	c.minInterval = time.Duration(msg.MinIntervalMs) * time.Millisecond
+}
+
+// payload builds the (possibly field-filtered) update this client should
+// receive for the given full snapshot JSON, honoring its minimum interval.
+// It returns nil if nothing should be sent yet.
+func (c *wsClient) payload(full []byte, fullMap map[string]json.RawMessage) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if c.minInterval > 0 && now.Sub(c.lastSent) < c.minInterval {
+		return nil
+	}
+	c.lastSent = now
+
+	if len(c.fields) == 0 {
+		return full
+	}
+	filtered := make(map[string]json.RawMessage, len(c.fields))
+	for f := range c.fields {
+		if v, ok := fullMap[f]; ok {
+			filtered[f] = v
+		}
+	}
+	j, err := json.Marshal(filtered)
+	if err != nil {
+		return nil
+	}
+	return j
+}
+
+// offer enqueues msg for delivery, applying backpressure: if the client's
+// send queue is full it is dropped rather than blocking the simulation
+// loop, and the caller should close the connection.
+func (c *wsClient) offer(msg []byte) bool {
+	select {
+	case c.send <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
 func main() {
-	// Initialize the simulation backend
-	sim := reactor.NewReactor()
+	cfg, err := loadAppConfig()
+	if err != nil {
+		log.Fatalf("loading config: %v", err)
+	}
+
+	// Initialize the simulation backend: a Grid of one or more reactors
+	// sharing a single demand curve, dispatched by headroom. REACTOR_COUNT
+	// defaults to 1, which is the original single-reactor server.
+	reactorCount := 1
+	if v := os.Getenv("REACTOR_COUNT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			log.Fatalf("REACTOR_COUNT must be a positive integer, got %q", v)
+		}
+		reactorCount = n
+	}
+	ids := make([]string, reactorCount)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+	grid, err := reactor.NewGrid(ids)
+	if err != nil {
+		log.Fatalf("creating grid: %v", err)
+	}
+	if err := grid.ApplyConfig(cfg.Reactor); err != nil {
+		log.Fatalf("applying config to grid: %v", err)
+	}
+	// sim is the grid's first reactor, which backs the original
+	// single-reactor endpoints (/api/state, /events, /ws, /api/scenario/*,
+	// /api/config). Multi-reactor dashboards should use
+	// /api/reactors/{id}/... and /api/grid/state instead.
+	sim, _ := grid.Reactor("1")
+
+	var auth authGuard
+	auth.set(cfg.BearerToken)
+
+	// Metrics and structured event log, driven off status transitions on
+	// every reactor in the grid, labeled by reactor id.
+	metrics := newReactorMetrics()
+	events := newEventLog()
+	grid.OnStatusChange(func(id string, prev, next reactor.ReactorStatus) {
+		events.record(id, prev, next)
+		metrics.observeStatusTransition(id, prev, next)
+	})
 
 	// SSE clients management
 	var clientsMu sync.Mutex
 	clients := make(map[chan []byte]struct{})
 
-	// Update loop (runs the simulation)
-	ticker := time.NewTicker(50 * time.Millisecond)
+	// WebSocket clients management
+	var wsClientsMu sync.Mutex
+	wsClients := make(map[*wsClient]struct{})
+
+	dropWSClient := func(c *wsClient) {
+		wsClientsMu.Lock()
+		_, present := wsClients[c]
+		delete(wsClients, c)
+		wsClientsMu.Unlock()
+		if present {
+			close(c.send)
+		}
+	}
+
+	// Update loop (runs the simulation). tickMu guards tickSeconds, which a
+	// SIGHUP reload can change alongside ticker.Reset.
+	var tickMu sync.Mutex
+	tickSeconds := cfg.Tick.Seconds()
+	ticker := time.NewTicker(cfg.Tick)
 	go func() {
 		lastJSON := []byte{}
 		for range ticker.C {
-			sim.Update(0.2) // delta seconds
+			if sim.IsScenarioRunning() {
+				// A Scenario drives the primary reactor directly at its own
+				// fixed delta; pause the grid's shared tick for all members
+				// so it doesn't desync that replay.
+				continue
+			}
+			tickMu.Lock()
+			delta := tickSeconds
+			tickMu.Unlock()
+			grid.Update(delta)
+			for _, id := range grid.IDs() {
+				r, _ := grid.Reactor(id)
+				metrics.observeSnapshot(id, r.Snapshot())
+			}
 			snap := sim.Snapshot()
 			j, _ := json.Marshal(snap)
 
@@ -42,6 +253,33 @@ func main() {
 				}
 				clientsMu.Unlock()
 			}
+
+			// WebSocket clients are fanned out every tick (independent of
+			// the SSE dedupe above) so per-client minIntervalMs throttling
+			// has real ticks to skip between.
+			wsClientsMu.Lock()
+			var overflowed []*wsClient
+			if len(wsClients) > 0 {
+				var fullMap map[string]json.RawMessage
+				_ = json.Unmarshal(j, &fullMap)
+				for c := range wsClients {
+					msg := c.payload(j, fullMap)
+					if msg == nil {
+						continue
+					}
+					if !c.offer(msg) {
+						overflowed = append(overflowed, c)
+					}
+				}
+			}
+			wsClientsMu.Unlock()
+			// Tear down overflowed clients through the same closeConn path
+			// the handler's own error branches use, so c.send is only ever
+			// closed once and the connection's reader/writer/heartbeat
+			// goroutines actually exit.
+			for _, c := range overflowed {
+				c.close(websocket.StatusPolicyViolation, "backpressure overflow")
+			}
 		}
 	}()
 
@@ -64,35 +302,13 @@ func main() {
 			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
-		var payload struct {
-			Type  string  `json:"type"`
-			Value float64 `json:"value"`
-		}
+		var payload actionPayload
 		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
 			http.Error(w, "bad request", http.StatusBadRequest)
 			return
 		}
-
-		// Delegate commands to backend methods
-		switch payload.Type {
-		case "powerOn":
-			sim.PowerOn()
-		case "powerOff":
-			sim.PowerOff()
-		case "scram":
-			sim.Scram()
-		case "toggleAuto":
-			sim.ToggleAuto()
-		case "refuel":
-			sim.Refuel()
-		case "setFissionRate":
-			sim.SetFissionRate(payload.Value)
-		case "setTurbineOutput":
-			sim.SetTurbineOutput(payload.Value)
-		case "setPowerLoad":
-			sim.SetPowerLoad(payload.Value)
-		default:
-			http.Error(w, "unknown action", http.StatusBadRequest)
+		if err := applyAction(sim, payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
 		w.WriteHeader(http.StatusNoContent)
@@ -135,6 +351,286 @@ func main() {
 		}
 	})
 
+	// WebSocket command/telemetry Endpoint: duplex replacement for /events
+	// + /api/action for latency-sensitive dashboards. Server pushes
+	// ReactorState deltas (optionally filtered via a "subscribe" message)
+	// and accepts the same action payloads /api/action does.
+	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		client := newWSClient()
+		ctx, cancel := context.WithCancel(r.Context())
+		var closeOnce sync.Once
+		closeConn := func(code websocket.StatusCode, reason string) {
+			closeOnce.Do(func() {
+				cancel()
+				dropWSClient(client)
+				conn.Close(code, reason)
+			})
+		}
+		client.close = closeConn
+
+		wsClientsMu.Lock()
+		wsClients[client] = struct{}{}
+		wsClientsMu.Unlock()
+
+		defer closeConn(websocket.StatusNormalClosure, "")
+
+		// Writer: drains the client's backpressure queue onto the socket.
+		go func() {
+			for msg := range client.send {
+				wctx, cancelWrite := context.WithTimeout(ctx, wsPongTimeout)
+				err := conn.Write(wctx, websocket.MessageText, msg)
+				cancelWrite()
+				if err != nil {
+					closeConn(websocket.StatusInternalError, "write failed")
+					return
+				}
+			}
+		}()
+
+		// Heartbeat: pings on an interval and drops the client if a pong
+		// doesn't arrive within wsPongTimeout.
+		go func() {
+			t := time.NewTicker(wsPingInterval)
+			defer t.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-t.C:
+					pctx, cancelPing := context.WithTimeout(ctx, wsPongTimeout)
+					err := conn.Ping(pctx)
+					cancelPing()
+					if err != nil {
+						closeConn(websocket.StatusPolicyViolation, "heartbeat timeout")
+						return
+					}
+				}
+			}
+		}()
+
+		// Reader: blocks in the handler goroutine, applying inbound
+		// actions and subscribe requests until the client disconnects.
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				return
+			}
+
+			var kind struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal(data, &kind); err != nil {
+				continue
+			}
+			if kind.Type == "subscribe" {
+				var sub wsSubscribeMsg
+				if err := json.Unmarshal(data, &sub); err == nil {
+					client.setSubscription(sub)
+				}
+				continue
+			}
+
+			var action actionPayload
+			if err := json.Unmarshal(data, &action); err != nil {
+				continue
+			}
+			_ = applyAction(sim, action)
+		}
+	})
+
+	// Per-reactor Endpoints: /api/reactors/{id}/state and
+	// /api/reactors/{id}/action mirror /api/state and /api/action, scoped
+	// to one grid member.
+	http.HandleFunc("/api/reactors/", func(w http.ResponseWriter, r *http.Request) {
+		rest := strings.TrimPrefix(r.URL.Path, "/api/reactors/")
+		parts := strings.SplitN(rest, "/", 2)
+		if len(parts) != 2 {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		id, sub := parts[0], parts[1]
+		target, ok := grid.Reactor(id)
+		if !ok {
+			http.Error(w, "unknown reactor id", http.StatusNotFound)
+			return
+		}
+		switch sub {
+		case "state":
+			if r.Method != http.MethodGet {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			j, _ := json.Marshal(target.Snapshot())
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(j)
+		case "action":
+			if r.Method != http.MethodPost {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			var payload actionPayload
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				http.Error(w, "bad request", http.StatusBadRequest)
+				return
+			}
+			if err := applyAction(target, payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	})
+
+	// Grid State Endpoint: aggregate demand, generation, reserve margin,
+	// and each member's contribution.
+	http.HandleFunc("/api/grid/state", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		j, _ := json.Marshal(grid.State())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(j)
+	})
+
+	// Scenario subsystem: load a timeline, start/stop replaying it against
+	// sim. Only one scenario can be loaded/running at a time.
+	var scenarioMu sync.Mutex
+	var loadedScenario *reactor.Scenario
+	var scenarioCancel context.CancelFunc
+
+	http.HandleFunc("/api/scenario/load", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var scenario reactor.Scenario
+		if err := json.NewDecoder(r.Body).Decode(&scenario); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		scenarioMu.Lock()
+		loadedScenario = &scenario
+		scenarioMu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	http.HandleFunc("/api/scenario/start", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		scenarioMu.Lock()
+		defer scenarioMu.Unlock()
+		if loadedScenario == nil {
+			http.Error(w, "no scenario loaded", http.StatusBadRequest)
+			return
+		}
+		if scenarioCancel != nil {
+			http.Error(w, "scenario already running", http.StatusConflict)
+			return
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		scenarioCancel = cancel
+		scenario := *loadedScenario
+		go func() {
+			if err := sim.Run(ctx, scenario); err != nil && err != context.Canceled {
+				log.Printf("scenario %q stopped: %v", scenario.Name, err)
+			}
+			scenarioMu.Lock()
+			scenarioCancel = nil
+			scenarioMu.Unlock()
+		}()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	http.HandleFunc("/api/scenario/stop", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		scenarioMu.Lock()
+		cancel := scenarioCancel
+		scenarioMu.Unlock()
+		if cancel == nil {
+			http.Error(w, "no scenario running", http.StatusBadRequest)
+			return
+		}
+		cancel()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// Prometheus metrics and structured event log
+	http.Handle("/metrics", metrics.handler())
+	http.HandleFunc("/api/events/log", events.handleEventsLog)
+
+	// Config Endpoint: GET returns the reactor's current tunables, PUT
+	// retunes them at runtime. Both are guarded by the optional bearer token.
+	http.HandleFunc("/api/config", func(w http.ResponseWriter, r *http.Request) {
+		if !auth.allow(r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			j, _ := json.Marshal(sim.ConfigOf())
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(j)
+		case http.MethodPut:
+			var rc reactor.Config
+			if err := json.NewDecoder(r.Body).Decode(&rc); err != nil {
+				http.Error(w, "bad request", http.StatusBadRequest)
+				return
+			}
+			// Retune the whole grid atomically, not just sim (reactor "1"),
+			// so a runtime retune can't leave some members on the new
+			// config and others on the old one.
+			if err := grid.ApplyConfig(rc); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// SIGHUP reload: re-reads reactor.yaml and applies its non-structural
+	// fields (thresholds, rates, spike parameters, tick interval, bearer
+	// token) without restarting the process.
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	go func() {
+		for range sighup {
+			reloaded := cfg
+			if err := reloaded.loadFile(); err != nil {
+				log.Printf("SIGHUP reload: %v", err)
+				continue
+			}
+			if err := reloaded.validate(); err != nil {
+				log.Printf("SIGHUP reload: %v", err)
+				continue
+			}
+			if err := grid.ApplyConfig(reloaded.Reactor); err != nil {
+				log.Printf("SIGHUP reload: %v", err)
+				continue
+			}
+			auth.set(reloaded.BearerToken)
+			tickMu.Lock()
+			tickSeconds = reloaded.Tick.Seconds()
+			tickMu.Unlock()
+			ticker.Reset(reloaded.Tick)
+			cfg = reloaded
+			log.Printf("SIGHUP reload: applied %s", cfg.ConfigPath)
+		}
+	}()
+
 	// Static File Server
 	staticDir := "frontend/"
 	if _, err := os.Stat(staticDir); os.IsNotExist(err) {
@@ -142,7 +638,6 @@ func main() {
 	}
 	http.Handle("/", http.FileServer(http.Dir(staticDir)))
 
-	port := 80
-	log.Printf("server listening :%d (static: %s)\n", port, staticDir)
-	log.Fatal(http.ListenAndServe(":"+strconv.Itoa(port), nil))
+	log.Printf("server listening %s (static: %s)\n", cfg.Listen, staticDir)
+	log.Fatal(http.ListenAndServe(cfg.Listen, nil))
 }
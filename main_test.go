@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWSClientOfferAppliesBackpressure(t *testing.T) {
+	c := newWSClient()
+	for i := 0; i < wsSendQueueSize; i++ {
+		if !c.offer([]byte("msg")) {
+			t.Fatalf("expected offer %d to succeed, queue should not be full yet", i)
+		}
+	}
+	if c.offer([]byte("overflow")) {
+		t.Fatal("expected offer to report backpressure once the send queue is full")
+	}
+}
+
+func TestWSClientSetSubscriptionCapsFieldCount(t *testing.T) {
+	c := newWSClient()
+	fields := make([]string, wsMaxSubFields+10)
+	for i := range fields {
+		fields[i] = "field" + strconv.Itoa(i) // distinct, so the cap is what limits the count
+	}
+	c.setSubscription(wsSubscribeMsg{Fields: fields})
+	if len(c.fields) > wsMaxSubFields {
+		t.Fatalf("expected at most %d subscribed fields, got %d", wsMaxSubFields, len(c.fields))
+	}
+}
+
+func TestWSClientSetSubscriptionEmptyFieldsMeansAll(t *testing.T) {
+	c := newWSClient()
+	c.setSubscription(wsSubscribeMsg{Fields: []string{"temperature"}})
+	if c.fields == nil {
+		t.Fatal("expected a non-nil filter after subscribing to a field")
+	}
+	c.setSubscription(wsSubscribeMsg{})
+	if c.fields != nil {
+		t.Fatal("expected an empty fields list to clear the filter back to \"all fields\"")
+	}
+}
+
+func TestWSClientPayloadFiltersToSubscribedFields(t *testing.T) {
+	c := newWSClient()
+	c.setSubscription(wsSubscribeMsg{Fields: []string{"temperature"}})
+
+	full := []byte(`{"temperature":500,"fissionRate":10}`)
+	var fullMap map[string]json.RawMessage
+	if err := json.Unmarshal(full, &fullMap); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+
+	got := c.payload(full, fullMap)
+	var gotMap map[string]json.RawMessage
+	if err := json.Unmarshal(got, &gotMap); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if _, ok := gotMap["fissionRate"]; ok {
+		t.Fatalf("expected fissionRate to be filtered out, got %s", got)
+	}
+	if _, ok := gotMap["temperature"]; !ok {
+		t.Fatalf("expected temperature to survive the filter, got %s", got)
+	}
+}
+
+func TestWSClientPayloadThrottlesToMinInterval(t *testing.T) {
+	c := newWSClient()
+	c.setSubscription(wsSubscribeMsg{MinIntervalMs: 1000})
+
+	full := []byte(`{"temperature":500}`)
+	var fullMap map[string]json.RawMessage
+	if err := json.Unmarshal(full, &fullMap); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+
+	if got := c.payload(full, fullMap); got == nil {
+		t.Fatal("expected the first payload after subscribing to be sent")
+	}
+	if got := c.payload(full, fullMap); got != nil {
+		t.Fatal("expected a payload within minIntervalMs of the last send to be suppressed")
+	}
+
+	c.mu.Lock()
+	c.lastSent = time.Now().Add(-2 * time.Second)
+	c.mu.Unlock()
+	if got := c.payload(full, fullMap); got == nil {
+		t.Fatal("expected a payload once minIntervalMs has elapsed")
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/zer0db/cnc-nuke-reactor/reactor"
+)
+
+// reactorMetrics holds the Prometheus collectors exported at /metrics,
+// labeled by reactor_id so a multi-reactor Grid reports per-unit series.
+type reactorMetrics struct {
+	temperature   *prometheus.GaugeVec
+	powerOutput   *prometheus.GaugeVec
+	powerLoad     *prometheus.GaugeVec
+	fuelCondition *prometheus.GaugeVec
+	fissionRate   *prometheus.GaugeVec
+	turbineOutput *prometheus.GaugeVec
+
+	statusTransitions *prometheus.CounterVec
+	meltdowns         *prometheus.CounterVec
+}
+
+func newReactorMetrics() *reactorMetrics {
+	gauge := func(name, help string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, []string{"reactor_id"})
+	}
+
+	m := &reactorMetrics{
+		temperature:   gauge("reactor_temperature_celsius", "Current core temperature."),
+		powerOutput:   gauge("reactor_power_output_kw", "Current power output."),
+		powerLoad:     gauge("reactor_power_load_kw", "Current grid power demand."),
+		fuelCondition: gauge("reactor_fuel_condition_percent", "Remaining fuel rod condition, 0 if no rod is loaded."),
+		fissionRate:   gauge("reactor_fission_rate", "Current fission rate setting (0-100)."),
+		turbineOutput: gauge("reactor_turbine_output", "Current turbine throttle setting (0-100)."),
+		statusTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reactor_status_transitions_total",
+			Help: "Count of reactor Status flag transitions, by reactor_id and from/to label.",
+		}, []string{"reactor_id", "from", "to"}),
+		meltdowns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reactor_meltdown_events_total",
+			Help: "Count of times a reactor entered StatusMeltdown.",
+		}, []string{"reactor_id"}),
+	}
+
+	prometheus.MustRegister(
+		m.temperature, m.powerOutput, m.powerLoad, m.fuelCondition,
+		m.fissionRate, m.turbineOutput, m.statusTransitions, m.meltdowns,
+	)
+	return m
+}
+
+// observeSnapshot updates one reactor's gauges from its latest snapshot.
+// It's called from the same tick loop that broadcasts to SSE/WS clients.
+func (m *reactorMetrics) observeSnapshot(id string, snap reactor.ReactorState) {
+	m.temperature.WithLabelValues(id).Set(snap.Temperature)
+	m.powerOutput.WithLabelValues(id).Set(snap.PowerOutput)
+	m.powerLoad.WithLabelValues(id).Set(snap.PowerLoad)
+	m.fissionRate.WithLabelValues(id).Set(snap.FissionRate)
+	m.turbineOutput.WithLabelValues(id).Set(snap.TurbineOutput)
+	if snap.FuelRod != nil {
+		m.fuelCondition.WithLabelValues(id).Set(snap.FuelRod.Condition)
+	} else {
+		m.fuelCondition.WithLabelValues(id).Set(0)
+	}
+}
+
+// observeStatusTransition records one reactor's Status flag change; meant
+// to be wired up via reactor.Grid.OnStatusChange alongside the event log.
+func (m *reactorMetrics) observeStatusTransition(id string, prev, next reactor.ReactorStatus) {
+	m.statusTransitions.WithLabelValues(id, prev.String(), next.String()).Inc()
+	if next&reactor.StatusMeltdown != 0 && prev&reactor.StatusMeltdown == 0 {
+		m.meltdowns.WithLabelValues(id).Inc()
+	}
+}
+
+func (m *reactorMetrics) handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,136 @@
+package reactor
+
+import "fmt"
+
+// Config is the set of tunable, non-structural reactor parameters:
+// thresholds, rates, and spike shape. It excludes structural state (fuel
+// rod presence, the active Model) which is changed through its own
+// dedicated methods instead. Config is what main.go loads from the reactor
+// section of reactor.yaml (no flag or environment variable sets it), and
+// what PUT /api/config accepts to retune a running reactor without a restart.
+type Config struct {
+	MaxTemp                float64 `yaml:"maxTemp" json:"maxTemp"`
+	MaxPowerOutput         float64 `yaml:"maxPowerOutput" json:"maxPowerOutput"`
+	MeltdownTemp           float64 `yaml:"meltdownTemp" json:"meltdownTemp"`
+	OverheatTemp           float64 `yaml:"overheatTemp" json:"overheatTemp"`
+	LowTemp                float64 `yaml:"lowTemp" json:"lowTemp"`
+	OptimalTemp            float64 `yaml:"optimalTemp" json:"optimalTemp"`
+	FuelConsumptionRate    float64 `yaml:"fuelConsumptionRate" json:"fuelConsumptionRate"`
+	HeatGenerationRate     float64 `yaml:"heatGenerationRate" json:"heatGenerationRate"`
+	AmbientTempDissipation float64 `yaml:"ambientTempDissipation" json:"ambientTempDissipation"`
+	TurbinePowerFactor     float64 `yaml:"turbinePowerFactor" json:"turbinePowerFactor"`
+	LowFuelThreshold       float64 `yaml:"lowFuelThreshold" json:"lowFuelThreshold"`
+	SpikeDuration          float64 `yaml:"spikeDuration" json:"spikeDuration"`
+	SpikeMagnitude         float64 `yaml:"spikeMagnitude" json:"spikeMagnitude"`
+}
+
+// DefaultConfig mirrors the constants NewReactor historically hard-coded;
+// it's the base layer flags/env/reactor.yaml overlay on top of.
+func DefaultConfig() Config {
+	return Config{
+		MaxTemp:                1000,
+		MaxPowerOutput:         5000,
+		MeltdownTemp:           900,
+		OverheatTemp:           600,
+		LowTemp:                200,
+		OptimalTemp:            350,
+		FuelConsumptionRate:    0.05,
+		HeatGenerationRate:     800,
+		AmbientTempDissipation: 0.05,
+		TurbinePowerFactor:     8,
+		LowFuelThreshold:       20,
+		SpikeDuration:          10.0,
+		SpikeMagnitude:         1000.0,
+	}
+}
+
+func (c Config) validate() error {
+	if c.LowTemp >= c.OptimalTemp {
+		return fmt.Errorf("reactor: config: lowTemp must be below optimalTemp")
+	}
+	if c.OptimalTemp >= c.OverheatTemp {
+		return fmt.Errorf("reactor: config: optimalTemp must be below overheatTemp")
+	}
+	if c.OverheatTemp >= c.MeltdownTemp {
+		return fmt.Errorf("reactor: config: overheatTemp must be below meltdownTemp")
+	}
+	if c.MeltdownTemp > c.MaxTemp {
+		return fmt.Errorf("reactor: config: meltdownTemp must not exceed maxTemp")
+	}
+	if c.MaxPowerOutput <= 0 {
+		return fmt.Errorf("reactor: config: maxPowerOutput must be > 0")
+	}
+	if c.HeatGenerationRate <= 0 {
+		return fmt.Errorf("reactor: config: heatGenerationRate must be > 0")
+	}
+	if c.TurbinePowerFactor <= 0 {
+		return fmt.Errorf("reactor: config: turbinePowerFactor must be > 0")
+	}
+	if c.FuelConsumptionRate < 0 || c.AmbientTempDissipation < 0 {
+		return fmt.Errorf("reactor: config: rates must be >= 0")
+	}
+	if c.LowFuelThreshold < 0 || c.LowFuelThreshold > 100 {
+		return fmt.Errorf("reactor: config: lowFuelThreshold must be within 0-100")
+	}
+	if c.SpikeDuration <= 0 {
+		return fmt.Errorf("reactor: config: spikeDuration must be > 0")
+	}
+	return nil
+}
+
+// applyConfigUnlocked copies cfg onto the reactor's constants. Callers must
+// hold r's write lock (or, as in NewReactor, be the only reference to r).
+func (r *Reactor) applyConfigUnlocked(cfg Config) {
+	r.MAX_TEMP = cfg.MaxTemp
+	r.MAX_POWER_OUTPUT = cfg.MaxPowerOutput
+	r.MELTDOWN_TEMP = cfg.MeltdownTemp
+	r.OVERHEAT_TEMP = cfg.OverheatTemp
+	r.LOW_TEMP = cfg.LowTemp
+	r.OPTIMAL_TEMP = cfg.OptimalTemp
+	r.FUEL_CONSUMPTION_RATE = cfg.FuelConsumptionRate
+	r.HEAT_GENERATION_RATE = cfg.HeatGenerationRate
+	r.AMBIENT_TEMP_DISSIPATION = cfg.AmbientTempDissipation
+	r.TURBINE_POWER_FACTOR = cfg.TurbinePowerFactor
+	r.LOW_FUEL_THRESHOLD = cfg.LowFuelThreshold
+	r.demand.spikeDuration = cfg.SpikeDuration
+	r.demand.spikeMagnitude = cfg.SpikeMagnitude
+}
+
+// ApplyConfig validates cfg and, if valid, swaps the reactor's tunable
+// constants atomically. It rejects configs that would immediately trip a
+// meltdown given the reactor's current temperature, so a bad retune can't
+// brick a running reactor.
+func (r *Reactor) ApplyConfig(cfg Config) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	r.Lock()
+	defer r.Unlock()
+	if r.state.Temperature >= cfg.MeltdownTemp {
+		return fmt.Errorf("reactor: config rejected: current temperature %.1f would immediately trip meltdown at %.1f", r.state.Temperature, cfg.MeltdownTemp)
+	}
+	r.applyConfigUnlocked(cfg)
+	return nil
+}
+
+// ConfigOf returns the reactor's current tunables, e.g. to serve GET
+// /api/config or to seed a reactor.yaml default.
+func (r *Reactor) ConfigOf() Config {
+	r.RLock()
+	defer r.RUnlock()
+	return Config{
+		MaxTemp:                r.MAX_TEMP,
+		MaxPowerOutput:         r.MAX_POWER_OUTPUT,
+		MeltdownTemp:           r.MELTDOWN_TEMP,
+		OverheatTemp:           r.OVERHEAT_TEMP,
+		LowTemp:                r.LOW_TEMP,
+		OptimalTemp:            r.OPTIMAL_TEMP,
+		FuelConsumptionRate:    r.FUEL_CONSUMPTION_RATE,
+		HeatGenerationRate:     r.HEAT_GENERATION_RATE,
+		AmbientTempDissipation: r.AMBIENT_TEMP_DISSIPATION,
+		TurbinePowerFactor:     r.TURBINE_POWER_FACTOR,
+		LowFuelThreshold:       r.LOW_FUEL_THRESHOLD,
+		SpikeDuration:          r.demand.spikeDuration,
+		SpikeMagnitude:         r.demand.spikeMagnitude,
+	}
+}
@@ -0,0 +1,67 @@
+package reactor
+
+import "testing"
+
+func TestConfigValidate(t *testing.T) {
+	valid := DefaultConfig()
+	if err := valid.validate(); err != nil {
+		t.Fatalf("expected DefaultConfig to validate, got %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		mutate func(c *Config)
+	}{
+		{"lowTemp >= optimalTemp", func(c *Config) { c.LowTemp = c.OptimalTemp }},
+		{"optimalTemp >= overheatTemp", func(c *Config) { c.OptimalTemp = c.OverheatTemp }},
+		{"overheatTemp >= meltdownTemp", func(c *Config) { c.OverheatTemp = c.MeltdownTemp }},
+		{"meltdownTemp > maxTemp", func(c *Config) { c.MeltdownTemp = c.MaxTemp + 1 }},
+		{"maxPowerOutput <= 0", func(c *Config) { c.MaxPowerOutput = 0 }},
+		{"heatGenerationRate <= 0", func(c *Config) { c.HeatGenerationRate = 0 }},
+		{"turbinePowerFactor <= 0", func(c *Config) { c.TurbinePowerFactor = 0 }},
+		{"fuelConsumptionRate < 0", func(c *Config) { c.FuelConsumptionRate = -1 }},
+		{"lowFuelThreshold out of range", func(c *Config) { c.LowFuelThreshold = 101 }},
+		{"spikeDuration <= 0", func(c *Config) { c.SpikeDuration = 0 }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tc.mutate(&cfg)
+			if err := cfg.validate(); err == nil {
+				t.Fatalf("expected %s to be rejected", tc.name)
+			}
+		})
+	}
+}
+
+func TestReactorApplyConfigRejectsImmediateMeltdown(t *testing.T) {
+	r := NewReactor()
+	baseline := r.MELTDOWN_TEMP // DefaultConfig's 900, set by NewReactor
+	r.state.Temperature = 950   // above the new meltdownTemp below
+
+	cfg := DefaultConfig()
+	cfg.MeltdownTemp = 940 // r's current temperature already exceeds this
+
+	if err := r.ApplyConfig(cfg); err == nil {
+		t.Fatal("expected ApplyConfig to reject a config that would immediately trip meltdown")
+	}
+	if r.MELTDOWN_TEMP != baseline {
+		t.Fatalf("expected the reactor's meltdown threshold to be left unchanged after a rejected ApplyConfig, got %v (baseline %v)", r.MELTDOWN_TEMP, baseline)
+	}
+}
+
+func TestReactorApplyConfigSwapsConstants(t *testing.T) {
+	r := NewReactor()
+
+	cfg := DefaultConfig()
+	cfg.MeltdownTemp = 850
+	cfg.OverheatTemp = 550
+
+	if err := r.ApplyConfig(cfg); err != nil {
+		t.Fatalf("ApplyConfig: %v", err)
+	}
+	got := r.ConfigOf()
+	if got.MeltdownTemp != cfg.MeltdownTemp || got.OverheatTemp != cfg.OverheatTemp {
+		t.Fatalf("expected ConfigOf to reflect the applied config, got %+v", got)
+	}
+}
@@ -0,0 +1,61 @@
+package reactor
+
+import (
+	"math"
+	"math/rand"
+)
+
+// demandCurve is the random-walk-plus-spike power demand formula shared by a
+// standalone Reactor (NewReactor) and a Grid: a slowly wandering base load
+// with occasional decaying spikes layered on top. A Grid owns exactly one
+// demandCurve for all its members; a standalone reactor owns its own.
+type demandCurve struct {
+	baseLoad       float64
+	spikeTimer     float64 // Counts down to next spike
+	isSpiking      bool
+	spikeElapsed   float64
+	spikeDuration  float64
+	spikeMagnitude float64
+}
+
+func newDemandCurve() demandCurve {
+	return demandCurve{
+		baseLoad:       1000.0,
+		spikeTimer:     10.0, // First spike in ~10 seconds (simulated time)
+		spikeDuration:  10.0,
+		spikeMagnitude: 1000.0,
+	}
+}
+
+// step advances the curve by delta using rng and returns the resulting
+// power load.
+func (d *demandCurve) step(rng *rand.Rand, delta float64) float64 {
+	// 1. Random Walk for Base Load
+	// Change ~ +/- 12 per tick.
+	change := (rng.Float64() * 24) - 12
+	d.baseLoad += change
+	d.baseLoad = clamp(d.baseLoad, 800, 2100)
+
+	// 2. Spike Logic
+	spikeVal := 0.0
+	if !d.isSpiking {
+		d.spikeTimer -= delta
+		if d.spikeTimer <= 0 {
+			d.isSpiking = true
+			d.spikeElapsed = 0
+			// Schedule next spike 10-15s after this one finishes
+			d.spikeTimer = 10.0 + rng.Float64()*5.0
+		}
+	} else {
+		d.spikeElapsed += delta
+		if d.spikeElapsed >= d.spikeDuration {
+			d.isSpiking = false
+		} else {
+			// Linear decay: magnitude * (1 - progress)
+			decayFactor := 1.0 - (d.spikeElapsed / d.spikeDuration)
+			spikeVal = d.spikeMagnitude * decayFactor
+		}
+	}
+
+	return math.Round(d.baseLoad + spikeVal)
+}
@@ -0,0 +1,254 @@
+package reactor
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Dispatcher allocates a grid's total power demand across its online
+// reactors, proportionally to each one's available headroom. Scrammed or
+// offline units get nothing, and the load they would have carried is
+// redistributed across the rest.
+type Dispatcher struct{}
+
+// Allocate returns each reactor's share of totalLoad, in the same order as
+// units. If no unit has headroom (all offline, or all already maxed out),
+// load is split evenly across whichever units are online so their
+// PowerLoad still reflects demand even though they can't meet it.
+func (Dispatcher) Allocate(totalLoad float64, units []*Reactor) []float64 {
+	shares := make([]float64, len(units))
+	headrooms := make([]float64, len(units))
+	var totalHeadroom float64
+	var onlineCount int
+	for i, u := range units {
+		headrooms[i] = u.Headroom()
+		totalHeadroom += headrooms[i]
+		snap := u.Snapshot()
+		if snap.IsPoweredOn && snap.Status&StatusScram == 0 {
+			onlineCount++
+		}
+	}
+
+	if totalHeadroom > 0 {
+		for i := range units {
+			shares[i] = totalLoad * (headrooms[i] / totalHeadroom)
+		}
+		return shares
+	}
+
+	if onlineCount == 0 {
+		return shares // every unit is offline; nobody gets any load
+	}
+	even := totalLoad / float64(onlineCount)
+	for i, u := range units {
+		snap := u.Snapshot()
+		if snap.IsPoweredOn && snap.Status&StatusScram == 0 {
+			shares[i] = even
+		}
+	}
+	return shares
+}
+
+// GridState is the aggregate snapshot served by /api/grid/state.
+type GridState struct {
+	TotalDemand   float64                 `json:"totalDemand"`
+	TotalOutput   float64                 `json:"totalOutput"`
+	ReserveMargin float64                 `json:"reserveMargin"`
+	Status        ReactorStatus           `json:"status"`
+	Reactors      map[string]ReactorState `json:"reactors"`
+}
+
+// Grid hosts N reactors sharing one stochastic demand curve: the random
+// walk and spike logic that used to live on every Reactor now runs once
+// here, and a Dispatcher splits the result across the member reactors.
+type Grid struct {
+	mu       sync.RWMutex
+	ids      []string
+	reactors []*Reactor
+	dispatch Dispatcher
+	rng      *rand.Rand
+
+	demand      demandCurve
+	currentLoad float64
+
+	brownoutWindow time.Duration
+	brownoutSince  time.Time
+	status         ReactorStatus
+}
+
+// NewGrid builds a Grid with one reactor per id, all running the "simple"
+// model. ids must be unique; they're the {id} segment under
+// /api/reactors/{id}/....
+func NewGrid(ids []string) (*Grid, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("reactor: grid needs at least one reactor id")
+	}
+	seen := make(map[string]bool, len(ids))
+	g := &Grid{
+		ids:            append([]string(nil), ids...),
+		rng:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		demand:         newDemandCurve(),
+		brownoutWindow: 5 * time.Second,
+	}
+	for _, id := range ids {
+		if seen[id] {
+			return nil, fmt.Errorf("reactor: duplicate grid reactor id %q", id)
+		}
+		seen[id] = true
+		r := NewReactor()
+		r.setGridManaged(true)
+		g.reactors = append(g.reactors, r)
+	}
+	return g, nil
+}
+
+// SetBrownoutWindow changes how long total output must lag total demand
+// before the grid is flagged StatusBrownout.
+func (g *Grid) SetBrownoutWindow(d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.brownoutWindow = d
+}
+
+// IDs returns the grid's reactor ids in a stable order.
+func (g *Grid) IDs() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return append([]string(nil), g.ids...)
+}
+
+// Reactor looks up a member reactor by id.
+func (g *Grid) Reactor(id string) (*Reactor, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for i, rid := range g.ids {
+		if rid == id {
+			return g.reactors[i], true
+		}
+	}
+	return nil, false
+}
+
+// OnStatusChange wires fn as every member reactor's status listener,
+// tagged with that reactor's id, e.g. to drive a shared event log or
+// per-reactor metrics.
+func (g *Grid) OnStatusChange(fn func(id string, prev, next ReactorStatus)) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for i, id := range g.ids {
+		id := id
+		g.reactors[i].SetStatusListener(func(prev, next ReactorStatus) {
+			fn(id, prev, next)
+		})
+	}
+}
+
+// ApplyConfig validates cfg and, if every reactor in the grid currently
+// accepts it, swaps all of their tunable constants atomically: either the
+// whole grid moves to cfg, or (if any member would immediately trip a
+// meltdown) none of them do. It holds every member's lock for the whole
+// check-then-apply so a concurrent Update can't observe, or be skipped by,
+// a partially-retuned grid.
+func (g *Grid) ApplyConfig(cfg Config) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	g.mu.RLock()
+	ids := append([]string(nil), g.ids...)
+	reactors := append([]*Reactor(nil), g.reactors...)
+	g.mu.RUnlock()
+
+	// Lock every member up front, always in the grid's stable id order, so
+	// the whole retune is a single critical section across the grid.
+	for _, r := range reactors {
+		r.Lock()
+	}
+	defer func() {
+		for _, r := range reactors {
+			r.Unlock()
+		}
+	}()
+
+	for i, r := range reactors {
+		if r.state.Temperature >= cfg.MeltdownTemp {
+			return fmt.Errorf("reactor: config rejected: reactor %s current temperature %.1f would immediately trip meltdown at %.1f", ids[i], r.state.Temperature, cfg.MeltdownTemp)
+		}
+	}
+	for _, r := range reactors {
+		r.applyConfigUnlocked(cfg)
+	}
+	return nil
+}
+
+// Update advances the shared demand curve by delta, dispatches the
+// resulting total load across member reactors by headroom, steps every
+// reactor, and re-evaluates StatusBrownout.
+func (g *Grid) Update(delta float64) {
+	g.mu.Lock()
+	g.updateDemandLocked(delta)
+	load := g.currentLoad
+	reactors := append([]*Reactor(nil), g.reactors...)
+	g.mu.Unlock()
+
+	shares := g.dispatch.Allocate(load, reactors)
+	for i, r := range reactors {
+		r.SetPowerLoad(shares[i])
+		r.Update(delta)
+	}
+
+	var totalOutput float64
+	for _, r := range reactors {
+		totalOutput += r.Snapshot().PowerOutput
+	}
+	g.updateBrownoutLocked(load, totalOutput)
+}
+
+// updateDemandLocked steps the grid's single shared demandCurve, the same
+// type a standalone Reactor uses for updateGridLoad, so the formula exists
+// in exactly one place regardless of how many reactors share it. Callers
+// must hold g.mu.
+func (g *Grid) updateDemandLocked(delta float64) {
+	g.currentLoad = g.demand.step(g.rng, delta)
+}
+
+func (g *Grid) updateBrownoutLocked(load, totalOutput float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if totalOutput < load {
+		if g.brownoutSince.IsZero() {
+			g.brownoutSince = time.Now()
+		} else if time.Since(g.brownoutSince) >= g.brownoutWindow {
+			g.status |= StatusBrownout
+		}
+		return
+	}
+	g.brownoutSince = time.Time{}
+	g.status &^= StatusBrownout
+}
+
+// State returns the grid's aggregate snapshot for /api/grid/state.
+func (g *Grid) State() GridState {
+	g.mu.RLock()
+	ids := append([]string(nil), g.ids...)
+	reactors := append([]*Reactor(nil), g.reactors...)
+	load := g.currentLoad
+	status := g.status
+	g.mu.RUnlock()
+
+	out := GridState{
+		TotalDemand: load,
+		Status:      status,
+		Reactors:    make(map[string]ReactorState, len(ids)),
+	}
+	for i, id := range ids {
+		snap := reactors[i].Snapshot()
+		out.Reactors[id] = snap
+		out.TotalOutput += snap.PowerOutput
+	}
+	out.ReserveMargin = out.TotalOutput - out.TotalDemand
+	return out
+}
@@ -0,0 +1,114 @@
+package reactor
+
+import "testing"
+
+func onlineReactor(maxPowerOutput, powerOutput float64) *Reactor {
+	return &Reactor{
+		MAX_POWER_OUTPUT: maxPowerOutput,
+		state:            ReactorState{IsPoweredOn: true, PowerOutput: powerOutput},
+	}
+}
+
+func scrammedReactor(maxPowerOutput float64) *Reactor {
+	return &Reactor{
+		MAX_POWER_OUTPUT: maxPowerOutput,
+		state:            ReactorState{IsPoweredOn: false, Status: StatusScram},
+	}
+}
+
+func TestDispatcherAllocateProportionalToHeadroom(t *testing.T) {
+	a := onlineReactor(1000, 0) // 1000 headroom
+	b := onlineReactor(3000, 0) // 3000 headroom
+
+	var d Dispatcher
+	shares := d.Allocate(400, []*Reactor{a, b})
+
+	if shares[0] != 100 || shares[1] != 300 {
+		t.Fatalf("expected shares proportional to headroom [100 300], got %v", shares)
+	}
+}
+
+func TestDispatcherAllocateRedistributesAwayFromScrammedUnits(t *testing.T) {
+	online := onlineReactor(1000, 0)
+	scrammed := scrammedReactor(1000)
+
+	var d Dispatcher
+	shares := d.Allocate(500, []*Reactor{online, scrammed})
+
+	if shares[0] != 500 {
+		t.Fatalf("expected the online unit to absorb all load, got %v", shares[0])
+	}
+	if shares[1] != 0 {
+		t.Fatalf("expected the scrammed unit to get no load, got %v", shares[1])
+	}
+}
+
+func TestDispatcherAllocateSplitsEvenlyWithNoHeadroom(t *testing.T) {
+	a := onlineReactor(1000, 1000) // maxed out, zero headroom
+	b := onlineReactor(1000, 1000)
+
+	var d Dispatcher
+	shares := d.Allocate(400, []*Reactor{a, b})
+
+	if shares[0] != 200 || shares[1] != 200 {
+		t.Fatalf("expected an even split [200 200] when no unit has headroom, got %v", shares)
+	}
+}
+
+// TestGridApplyConfigIsAtomicAcrossMembers checks the guarantee ApplyConfig's
+// doc comment makes once extended across a grid: if any member would
+// immediately trip a meltdown under the new config, the whole retune is
+// rejected and no member is left on the new config, not even the ones that
+// would have accepted it.
+func TestGridApplyConfigIsAtomicAcrossMembers(t *testing.T) {
+	g, err := NewGrid([]string{"1", "2"})
+	if err != nil {
+		t.Fatalf("NewGrid: %v", err)
+	}
+	hot, _ := g.Reactor("1")
+	cold, _ := g.Reactor("2")
+	hot.state.Temperature = 950
+
+	baseline := DefaultConfig().MeltdownTemp
+	cfg := DefaultConfig()
+	cfg.MeltdownTemp = 940 // below hot's current temperature, above cold's
+
+	if err := g.ApplyConfig(cfg); err == nil {
+		t.Fatal("expected ApplyConfig to reject a config that would trip meltdown on one member")
+	}
+	if hot.MELTDOWN_TEMP != baseline || cold.MELTDOWN_TEMP != baseline {
+		t.Fatalf("expected neither reactor to be retuned on a rejected grid config, got hot=%v cold=%v (baseline %v)",
+			hot.MELTDOWN_TEMP, cold.MELTDOWN_TEMP, baseline)
+	}
+}
+
+func TestGridApplyConfigAppliesToEveryMemberOnSuccess(t *testing.T) {
+	g, err := NewGrid([]string{"1", "2"})
+	if err != nil {
+		t.Fatalf("NewGrid: %v", err)
+	}
+	cfg := DefaultConfig()
+	cfg.MeltdownTemp = 850
+
+	if err := g.ApplyConfig(cfg); err != nil {
+		t.Fatalf("ApplyConfig: %v", err)
+	}
+	for _, id := range g.IDs() {
+		r, _ := g.Reactor(id)
+		if r.MELTDOWN_TEMP != cfg.MeltdownTemp {
+			t.Fatalf("reactor %s: expected MELTDOWN_TEMP %v, got %v", id, cfg.MeltdownTemp, r.MELTDOWN_TEMP)
+		}
+	}
+}
+
+func TestDispatcherAllocateGivesNobodyLoadWhenAllOffline(t *testing.T) {
+	a := scrammedReactor(1000)
+	b := scrammedReactor(1000)
+
+	var d Dispatcher
+	shares := d.Allocate(400, []*Reactor{a, b})
+
+	if shares[0] != 0 || shares[1] != 0 {
+		t.Fatalf("expected no load allocated when every unit is offline, got %v", shares)
+	}
+}
@@ -0,0 +1,69 @@
+package reactor
+
+import "fmt"
+
+// ControlInputs is the control-room state a Model reacts to each tick. It
+// excludes anything Update derives itself (fuel consumption, status flags)
+// so a Model only has to own the thermal/neutronic math.
+type ControlInputs struct {
+	FissionRate   float64
+	TurbineOutput float64
+	PowerLoad     float64
+	IsPoweredOn   bool
+	IsScrammed    bool
+}
+
+// PhysicsOutputs is what a Model computes for one step. Update applies it to
+// the shared ReactorState and layers fuel consumption / status derivation on
+// top, the same for every model.
+type PhysicsOutputs struct {
+	Temperature   float64
+	PowerOutput   float64
+	HeatGenerated float64
+}
+
+// Model is the pluggable thermal/neutronic core behind Reactor.Update. Each
+// model owns any internal state it needs (e.g. precursor concentrations) and
+// is responsible for producing new temperature/power values each step.
+type Model interface {
+	// Step advances the model by delta seconds given the current reactor
+	// state and control inputs, returning the new physics outputs. state is
+	// read-only from the model's perspective; Update is the only place that
+	// writes it back.
+	Step(state *ReactorState, delta float64, ctrl ControlInputs) PhysicsOutputs
+}
+
+// modelRegistry maps a model name (as accepted by NewReactorWithModel and
+// the setModel action) to a constructor. Constructors take the owning
+// Reactor so a model can read its tunable constants (HEAT_GENERATION_RATE,
+// OVERHEAT_TEMP, ...), which stay on Reactor since ApplyConfig retunes them
+// at runtime regardless of which model is active.
+var modelRegistry = map[string]func(*Reactor) Model{
+	"simple":        func(r *Reactor) Model { return newSimpleModel(r) },
+	"pointkinetics": func(r *Reactor) Model { return newPointKineticsModel(r) },
+}
+
+// SetModel swaps the reactor's active physics model by name. The model's
+// internal state (precursor concentrations, etc.) is reset.
+func (r *Reactor) SetModel(name string) error {
+	ctor, ok := modelRegistry[name]
+	if !ok {
+		return fmt.Errorf("reactor: unknown model %q", name)
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.model = ctor(r)
+	r.modelName = name
+	r.state.Model = name
+	return nil
+}
+
+// NewReactorWithModel constructs a Reactor and immediately switches it to
+// the named model, e.g. "simple" or "pointkinetics".
+func NewReactorWithModel(name string) (*Reactor, error) {
+	r := NewReactor()
+	if err := r.SetModel(name); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
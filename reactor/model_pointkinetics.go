@@ -0,0 +1,136 @@
+package reactor
+
+import "math"
+
+// Six-group delayed-neutron data for thermal fission of U-235 (Lamarsh),
+// used as the fixed precursor constants for the "pointkinetics" model.
+var (
+	pkBeta = [6]float64{
+		0.000215, 0.001424, 0.001274, 0.002568, 0.000748, 0.000273,
+	}
+	pkLambda = [6]float64{
+		0.0124, 0.0305, 0.111, 0.301, 1.14, 3.01,
+	}
+)
+
+const (
+	pkPromptGenTime = 2e-5 // Lambda, seconds (thermal reactor)
+	pkMaxSubstep    = 0.01 // seconds; target substep size for integration accuracy
+	pkMaxSubsteps   = 64   // caps the substep count, so substeps exceed pkMaxSubstep once delta > 0.64s
+	pkRodGain       = 0.05 // how fast control-rod reactivity chases the fission-rate setpoint
+	pkRodLimit      = 5.0  // rod reactivity clamp, in multiples of betaTotal
+	pkRhoClamp      = 10.0 // overall reactivity clamp, in multiples of betaTotal
+
+	// temperature reactivity feedback coefficient: negative, so the model is
+	// self-limiting (Doppler broadening / coolant density effects) even with
+	// the rods held fixed.
+	pkTempCoeff = -3e-5
+	pkMaxN      = 1e6 // ceiling on relative neutron population n, keeps a runaway finite
+)
+
+// pointKineticsModel integrates the standard six-group point-kinetics
+// equations:
+//
+//	dn/dt  = (rho-beta)/Lambda * n + sum(lambda_i * C_i)
+//	dC_i/dt = beta_i/Lambda * n - lambda_i * C_i
+//
+// plus a lumped fuel/coolant thermal node, where n is the neutron
+// population relative to the equilibrium population at FissionRate=100.
+// The n update is semi-implicit (backward Euler), which stays stable even
+// though delta is many orders of magnitude larger than Lambda; reactivity
+// is clamped so a runaway still terminates in StatusMeltdown via the
+// temperature threshold rather than overflowing to NaN/Inf.
+type pointKineticsModel struct {
+	r *Reactor
+
+	n             float64
+	c             [6]float64
+	rodReactivity float64
+	betaTotal     float64
+}
+
+func newPointKineticsModel(r *Reactor) *pointKineticsModel {
+	m := &pointKineticsModel{r: r}
+	for _, b := range pkBeta {
+		m.betaTotal += b
+	}
+
+	// Seed n and the precursors at equilibrium for the reactor's current
+	// fission rate, so switching models mid-run doesn't snap power to zero.
+	n0 := clamp(r.state.FissionRate/100.0, 0, 1)
+	m.n = n0
+	for i := range m.c {
+		m.c[i] = (pkBeta[i] / pkPromptGenTime) * n0 / pkLambda[i]
+	}
+	return m
+}
+
+func (m *pointKineticsModel) Step(state *ReactorState, delta float64, ctrl ControlInputs) PhysicsOutputs {
+	r := m.r
+	shutdown := !ctrl.IsPoweredOn || ctrl.IsScrammed
+
+	target := clamp(ctrl.FissionRate/100.0, 0, 1)
+	if shutdown {
+		target = 0
+	}
+
+	turbineOutput := ctrl.TurbineOutput
+	ambientFactor := r.AMBIENT_TEMP_DISSIPATION
+	if shutdown {
+		// Mirrors the simple model's shutdown-cooling branch: turbine
+		// throttle freezes where it was and ambient cooling doubles.
+		turbineOutput = state.TurbineOutput
+		ambientFactor *= 2
+	}
+
+	substeps := int(math.Ceil(delta / pkMaxSubstep))
+	substeps = int(clamp(float64(substeps), 1, pkMaxSubsteps))
+	h := delta / float64(substeps)
+
+	temperature := state.Temperature
+	var heatGenerated, powerOutput float64
+
+	for s := 0; s < substeps; s++ {
+		rodErr := target - m.n
+		m.rodReactivity = clamp(m.rodReactivity+rodErr*pkRodGain*h, -pkRodLimit*m.betaTotal, pkRodLimit*m.betaTotal)
+
+		tempFeedback := pkTempCoeff * (temperature - r.OPTIMAL_TEMP)
+		rho := clamp(m.rodReactivity+tempFeedback, -pkRhoClamp*m.betaTotal, pkRhoClamp*m.betaTotal)
+
+		precursorSource := 0.0
+		for i := range m.c {
+			precursorSource += pkLambda[i] * m.c[i]
+		}
+
+		// Semi-implicit (backward Euler) update of n: unconditionally
+		// stable regardless of how large h is relative to pkPromptGenTime.
+		denom := 1 - h*(rho-m.betaTotal)/pkPromptGenTime
+		if denom < 1e-6 {
+			denom = 1e-6
+		}
+		m.n = clamp((m.n+h*precursorSource)/denom, 0, pkMaxN)
+
+		for i := range m.c {
+			m.c[i] = (m.c[i] + h*(pkBeta[i]/pkPromptGenTime)*m.n) / (1 + h*pkLambda[i])
+		}
+
+		stepHeat := m.n * r.HEAT_GENERATION_RATE
+		heatGenerated += stepHeat * (h / delta) // time-weighted average for reporting
+		if state.FuelRod != nil && state.FuelRod.Condition > 0 {
+			temperature += stepHeat * h
+		}
+
+		tempEfficiency := min(1, temperature/r.OVERHEAT_TEMP)
+		potentialPower := temperature * (turbineOutput / 100.0) * tempEfficiency
+		powerOutput = min(r.MAX_POWER_OUTPUT, potentialPower*r.TURBINE_POWER_FACTOR)
+		heatConsumedByTurbine := powerOutput / r.TURBINE_POWER_FACTOR
+		ambientCooling := temperature * ambientFactor
+		temperature -= (heatConsumedByTurbine + ambientCooling) * h
+		if temperature < 0 {
+			temperature = 0
+			powerOutput = 0
+		}
+	}
+
+	return PhysicsOutputs{Temperature: temperature, PowerOutput: powerOutput, HeatGenerated: heatGenerated}
+}
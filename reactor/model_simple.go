@@ -0,0 +1,50 @@
+package reactor
+
+// simpleModel is the original arcade-style thermal model: fission rate maps
+// linearly onto heat generation, and power output is a simple function of
+// temperature and turbine throttle. It is registered as "simple" and is the
+// default for NewReactor.
+type simpleModel struct {
+	r *Reactor
+}
+
+func newSimpleModel(r *Reactor) *simpleModel {
+	return &simpleModel{r: r}
+}
+
+func (m *simpleModel) Step(state *ReactorState, delta float64, ctrl ControlInputs) PhysicsOutputs {
+	r := m.r
+
+	if !ctrl.IsPoweredOn || ctrl.IsScrammed {
+		tempEfficiency := min(1, state.Temperature/r.OVERHEAT_TEMP)
+		potentialPower := state.Temperature * (state.TurbineOutput / 100.0) * tempEfficiency
+		powerOutput := min(r.MAX_POWER_OUTPUT, potentialPower*r.TURBINE_POWER_FACTOR)
+		heatConsumedByTurbine := powerOutput / r.TURBINE_POWER_FACTOR
+		ambientCooling := state.Temperature * (r.AMBIENT_TEMP_DISSIPATION * 2)
+		temperature := state.Temperature - (heatConsumedByTurbine+ambientCooling)*delta
+		if temperature < 0 {
+			temperature = 0
+			powerOutput = 0
+		}
+		return PhysicsOutputs{Temperature: temperature, PowerOutput: powerOutput}
+	}
+
+	temperature := state.Temperature
+	heatGenerated := 0.0
+	if state.FuelRod != nil && state.FuelRod.Condition > 0 {
+		heatGenerated = (ctrl.FissionRate / 100.0) * r.HEAT_GENERATION_RATE
+		temperature += heatGenerated * delta
+	}
+
+	tempEfficiency := min(1, temperature/r.OVERHEAT_TEMP)
+	potentialPower := temperature * (ctrl.TurbineOutput / 100.0) * tempEfficiency
+	powerOutput := min(r.MAX_POWER_OUTPUT, potentialPower*r.TURBINE_POWER_FACTOR)
+	heatConsumedByTurbine := powerOutput / r.TURBINE_POWER_FACTOR
+	ambientCooling := temperature * r.AMBIENT_TEMP_DISSIPATION
+	temperature -= (heatConsumedByTurbine + ambientCooling) * delta
+	if temperature < 0 {
+		temperature = 0
+	}
+
+	return PhysicsOutputs{Temperature: temperature, PowerOutput: powerOutput, HeatGenerated: heatGenerated}
+}
@@ -0,0 +1,114 @@
+package reactor
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// sameScenario returns two independent copies of the same scenario.Events
+// slice so concurrent/sequential Run calls can't see each other's mutations.
+func sameScenario() Scenario {
+	return Scenario{
+		Name:  "determinism-check",
+		Seed:  42,
+		Delta: 0.01,
+		Events: []ScenarioEvent{
+			{AtSeconds: 0.02, Type: "setPowerLoad", Value: 1200},
+			{AtSeconds: 0.03, Type: "injectFault:coolantLoss"},
+			{AtSeconds: 0.04, Type: "spike", Value: 500},
+			{AtSeconds: 0.05, Type: "forceFissionRate", Value: 60},
+		},
+	}
+}
+
+func runScenario(t *testing.T, s Scenario) ReactorState {
+	t.Helper()
+	r := NewReactor()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := r.Run(ctx, s); err != nil {
+		t.Fatalf("scenario run: %v", err)
+	}
+	return r.Snapshot()
+}
+
+// TestScenarioRunIsDeterministic checks the guarantee Scenario's doc comment
+// makes: the same seed and event timeline reproduce the same outcome
+// byte-for-byte, which is what makes replay useful for regression tests and
+// bug-report reproduction.
+func TestScenarioRunIsDeterministic(t *testing.T) {
+	a := runScenario(t, sameScenario())
+	b := runScenario(t, sameScenario())
+
+	if a.Temperature != b.Temperature ||
+		a.FissionRate != b.FissionRate ||
+		a.TurbineOutput != b.TurbineOutput ||
+		a.PowerOutput != b.PowerOutput ||
+		a.PowerLoad != b.PowerLoad ||
+		a.Status != b.Status {
+		t.Fatalf("two runs of the same scenario diverged:\n a=%+v\n b=%+v", a, b)
+	}
+	if (a.FuelRod == nil) != (b.FuelRod == nil) {
+		t.Fatalf("fuel rod presence diverged: a=%v b=%v", a.FuelRod, b.FuelRod)
+	}
+	if a.FuelRod != nil && a.FuelRod.Condition != b.FuelRod.Condition {
+		t.Fatalf("fuel rod condition diverged: a=%v b=%v", a.FuelRod.Condition, b.FuelRod.Condition)
+	}
+}
+
+// TestScenarioRunDifferentSeedsCanDiverge is a sanity check on the above: if
+// every run produced the same result regardless of seed, the determinism
+// test would be vacuous (e.g. if Run forgot to call SetSeed).
+func TestScenarioRunDifferentSeedsCanDiverge(t *testing.T) {
+	s1 := sameScenario()
+	s1.Seed = 1
+	s2 := sameScenario()
+	s2.Seed = 2
+
+	a := runScenario(t, s1)
+	b := runScenario(t, s2)
+
+	if a.PowerLoad == b.PowerLoad && a.Temperature == b.Temperature {
+		t.Fatalf("expected different seeds to produce at least one different load/temperature walk, got identical results")
+	}
+}
+
+// TestScenarioRunFastForwardMatchesRealTime checks that a negative Speed
+// (flat out, no wall-clock pacing) reproduces the same outcome as the
+// default real-time pacing, and does it well within the scenario's own
+// simulated duration — the whole point of fast-forward being available for
+// regression tests replaying long scenarios.
+func TestScenarioRunFastForwardMatchesRealTime(t *testing.T) {
+	realTime := sameScenario()
+	fastForward := sameScenario()
+	fastForward.Speed = -1
+
+	realTimeState := runScenario(t, realTime)
+
+	start := time.Now()
+	fastState := runScenario(t, fastForward)
+	if elapsed := time.Since(start); elapsed >= time.Duration(fastForward.Delta*float64(len(fastForward.Events)+1)*float64(time.Second)) {
+		t.Fatalf("fast-forward run took %s, expected it to finish well under simulated time", elapsed)
+	}
+
+	if realTimeState.Temperature != fastState.Temperature ||
+		realTimeState.PowerLoad != fastState.PowerLoad ||
+		realTimeState.Status != fastState.Status {
+		t.Fatalf("fast-forward outcome diverged from real-time outcome:\n realTime=%+v\n fast=%+v", realTimeState, fastState)
+	}
+}
+
+func TestScenarioValidateRejectsUnknownEventType(t *testing.T) {
+	s := Scenario{Delta: 0.01, Events: []ScenarioEvent{{Type: "doTheImpossible"}}}
+	if err := s.validate(); err == nil {
+		t.Fatal("expected an error for an unknown event type")
+	}
+}
+
+func TestScenarioValidateRejectsNonPositiveDelta(t *testing.T) {
+	s := Scenario{Delta: 0}
+	if err := s.validate(); err == nil {
+		t.Fatal("expected an error for a non-positive delta")
+	}
+}
@@ -1,9 +1,9 @@
 package reactor
 
 import (
-	"math"
 	"math/rand"
 	"sync"
+	"time"
 )
 
 // ReactorStatus flags
@@ -19,8 +19,47 @@ const (
 	StatusFuelOut                  = 1 << 5
 	StatusMeltdown                 = 1 << 6
 	StatusScram                    = 1 << 7
+
+	// StatusBrownout is set on a Grid's aggregate GridState.Status, not on
+	// any single Reactor's status. See Grid.updateBrownoutLocked.
+	StatusBrownout = 1 << 8
 )
 
+var statusNames = []struct {
+	flag ReactorStatus
+	name string
+}{
+	{StatusTempLow, "tempLow"},
+	{StatusOverheat, "overheat"},
+	{StatusOutputLow, "outputLow"},
+	{StatusOutputHigh, "outputHigh"},
+	{StatusFuelLow, "fuelLow"},
+	{StatusFuelOut, "fuelOut"},
+	{StatusMeltdown, "meltdown"},
+	{StatusScram, "scram"},
+	{StatusBrownout, "brownout"},
+}
+
+// String renders the set status flags as a comma-separated list (e.g.
+// "overheat,outputHigh"), or "none" if no flags are set. Used for logging
+// and metric labels, where ReactorStatus's numeric value isn't legible.
+func (s ReactorStatus) String() string {
+	if s == StatusNone {
+		return "none"
+	}
+	out := ""
+	for _, sn := range statusNames {
+		if s&sn.flag == 0 {
+			continue
+		}
+		if out != "" {
+			out += ","
+		}
+		out += sn.name
+	}
+	return out
+}
+
 type FuelRod struct {
 	Condition float64 `json:"condition"`
 }
@@ -35,6 +74,7 @@ type ReactorState struct {
 	PowerLoad     float64       `json:"powerLoad"`
 	FuelRod       *FuelRod      `json:"fuelRod"`
 	Status        ReactorStatus `json:"status"`
+	Model         string        `json:"model"`
 }
 
 type Reactor struct {
@@ -53,34 +93,58 @@ type Reactor struct {
 	AMBIENT_TEMP_DISSIPATION float64
 	TURBINE_POWER_FACTOR     float64
 	LOW_FUEL_THRESHOLD       float64
-	baseLoad                 float64
-	spikeTimer               float64 // Counts down to next spike
-	isSpiking                bool
-	spikeElapsed             float64
-	spikeDuration            float64
-	spikeMagnitude           float64
+
+	// demand is this reactor's own demand curve, stepped by updateGridLoad.
+	// A gridManaged reactor ignores it in favor of the Grid's single shared
+	// curve (see Grid.updateDemandLocked).
+	demand demandCurve
+
+	model     Model
+	modelName string
+
+	// rng drives updateGridLoad's random walk and spike timers. It defaults
+	// to a time-seeded source but can be replaced with SetSeed so a
+	// Scenario can reproduce a run byte-for-byte.
+	rng *rand.Rand
+
+	scenarioRunning bool
+
+	statusListener func(prev, next ReactorStatus)
+
+	// gridManaged is true for a reactor owned by a Grid, whose Dispatcher
+	// sets PowerLoad directly from a single shared demand curve each tick.
+	// Update skips its own updateGridLoad in that case, so N reactors in a
+	// Grid don't each generate their own independent demand. While a Scenario
+	// runs on it, Update steps the reactor's own seeded demand curve instead.
+	gridManaged bool
+}
+
+func (r *Reactor) setGridManaged(v bool) {
+	r.Lock()
+	defer r.Unlock()
+	r.gridManaged = v
+}
+
+// Headroom is the reactor's remaining generation capacity: how much more
+// power it could produce before hitting MAX_POWER_OUTPUT. An offline or
+// scrammed reactor has zero headroom regardless of its rated capacity.
+func (r *Reactor) Headroom() float64 {
+	r.RLock()
+	defer r.RUnlock()
+	if !r.state.IsPoweredOn || (r.state.Status&StatusScram) != 0 {
+		return 0
+	}
+	return max(0, r.MAX_POWER_OUTPUT-r.state.PowerOutput)
 }
 
 func NewReactor() *Reactor {
 	r := &Reactor{
-		MAX_TEMP:                 1000,
-		MAX_POWER_OUTPUT:         5000,
-		MELTDOWN_TEMP:            900,
-		OVERHEAT_TEMP:            600,
-		LOW_TEMP:                 200,
-		OPTIMAL_TEMP:             350,
-		FUEL_CONSUMPTION_RATE:    0.05,
-		HEAT_GENERATION_RATE:     800,
-		AMBIENT_TEMP_DISSIPATION: 0.05,
-		TURBINE_POWER_FACTOR:     8,
-		LOW_FUEL_THRESHOLD:       20,
+		rng:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		demand: newDemandCurve(),
 	}
+	r.applyConfigUnlocked(DefaultConfig())
 
-	initialLoad := 1000.0
-	r.baseLoad = initialLoad
-	r.spikeTimer = 10.0 // First spike in ~10 seconds (simulated time)
-	r.spikeDuration = 10.0
-	r.spikeMagnitude = 1000.0
+	initialLoad := r.demand.baseLoad
 	initialTemp := r.OPTIMAL_TEMP
 	initialTurbine := initialLoad / (initialTemp / 100.0 * (initialTemp / r.OVERHEAT_TEMP) * r.TURBINE_POWER_FACTOR)
 	heatConsumed := (initialLoad / r.TURBINE_POWER_FACTOR) + (initialTemp * r.AMBIENT_TEMP_DISSIPATION)
@@ -97,53 +161,63 @@ func NewReactor() *Reactor {
 		FuelRod:       &FuelRod{Condition: 100},
 		Status:        StatusNone,
 	}
+
+	r.model = newSimpleModel(r)
+	r.modelName = "simple"
+	r.state.Model = r.modelName
 	return r
 }
 
 func (r *Reactor) Update(delta float64) {
 	r.Lock()
-	defer r.Unlock()
 
-	r.updateGridLoad(delta)
-	if !r.state.IsPoweredOn || (r.state.Status&StatusScram) != 0 {
-		// shutdown cooling behaviour
-		r.state.FissionRate = 0
-		tempEfficiency := min(1, r.state.Temperature/r.OVERHEAT_TEMP)
-		potentialPower := r.state.Temperature * (r.state.TurbineOutput / 100.0) * tempEfficiency
-		r.state.PowerOutput = min(r.MAX_POWER_OUTPUT, potentialPower*r.TURBINE_POWER_FACTOR)
-		heatConsumedByTurbine := r.state.PowerOutput / r.TURBINE_POWER_FACTOR
-		ambientCooling := r.state.Temperature * (r.AMBIENT_TEMP_DISSIPATION * 2)
-		r.state.Temperature -= (heatConsumedByTurbine + ambientCooling) * delta
-		if r.state.Temperature < 0 {
-			r.state.Temperature = 0
-			r.state.PowerOutput = 0
-		}
-		r.updateStatusLocked()
-		return
+	if !r.gridManaged || r.scenarioRunning {
+		r.updateGridLoad(delta)
 	}
 
-	if r.state.IsAutoControl {
+	shutdown := !r.state.IsPoweredOn || (r.state.Status&StatusScram) != 0
+	if shutdown {
+		r.state.FissionRate = 0
+	} else if r.state.IsAutoControl {
 		r.runAutoControlLocked()
 	}
 
-	heatGenerated := 0.0
-	if r.state.FuelRod != nil && r.state.FuelRod.Condition > 0 {
-		heatGenerated = (r.state.FissionRate / 100.0) * r.HEAT_GENERATION_RATE
-		r.state.Temperature += heatGenerated * delta
-		fuelConsumed := (r.state.FissionRate / 100.0) * r.FUEL_CONSUMPTION_RATE * delta
-		r.state.FuelRod.Condition = max(0, r.state.FuelRod.Condition-fuelConsumed)
+	ctrl := ControlInputs{
+		FissionRate:   r.state.FissionRate,
+		TurbineOutput: r.state.TurbineOutput,
+		PowerLoad:     r.state.PowerLoad,
+		IsPoweredOn:   r.state.IsPoweredOn,
+		IsScrammed:    (r.state.Status & StatusScram) != 0,
 	}
+	out := r.model.Step(&r.state, delta, ctrl)
+	r.state.Temperature = out.Temperature
+	r.state.PowerOutput = out.PowerOutput
 
-	tempEfficiency := min(1, r.state.Temperature/r.OVERHEAT_TEMP)
-	potentialPower := r.state.Temperature * (r.state.TurbineOutput / 100.0) * tempEfficiency
-	r.state.PowerOutput = min(r.MAX_POWER_OUTPUT, potentialPower*r.TURBINE_POWER_FACTOR)
-	heatConsumedByTurbine := r.state.PowerOutput / r.TURBINE_POWER_FACTOR
-	ambientCooling := r.state.Temperature * r.AMBIENT_TEMP_DISSIPATION
-	r.state.Temperature -= (heatConsumedByTurbine + ambientCooling) * delta
-	if r.state.Temperature < 0 {
-		r.state.Temperature = 0
+	if !shutdown && r.state.FuelRod != nil && r.state.FuelRod.Condition > 0 {
+		fuelConsumed := (ctrl.FissionRate / 100.0) * r.FUEL_CONSUMPTION_RATE * delta
+		r.state.FuelRod.Condition = max(0, r.state.FuelRod.Condition-fuelConsumed)
 	}
+
+	prevStatus := r.state.Status
 	r.updateStatusLocked()
+	newStatus := r.state.Status
+	listener := r.statusListener
+	r.Unlock()
+
+	// Invoked outside the lock so a listener is free to call back into the
+	// reactor (e.g. Snapshot) without deadlocking.
+	if listener != nil && newStatus != prevStatus {
+		listener(prevStatus, newStatus)
+	}
+}
+
+// SetStatusListener registers a callback invoked whenever updateStatusLocked
+// changes r.state.Status, with the previous and new flags. Used to drive
+// structured event logging and metrics off status transitions.
+func (r *Reactor) SetStatusListener(fn func(prev, next ReactorStatus)) {
+	r.Lock()
+	defer r.Unlock()
+	r.statusListener = fn
 }
 
 func (r *Reactor) runAutoControlLocked() {
@@ -184,36 +258,12 @@ func (r *Reactor) updateStatusLocked() {
 	r.state.Status = current
 }
 
+// updateGridLoad steps this reactor's own demandCurve. Only called for a
+// standalone (non-gridManaged) reactor or one running a Scenario; otherwise a
+// Grid member's PowerLoad is set by the Grid's shared curve (see
+// Reactor.gridManaged).
 func (r *Reactor) updateGridLoad(delta float64) {
-	// 1. Random Walk for Base Load
-	// Change ~ +/- 12 per tick.
-	change := (rand.Float64() * 24) - 12
-	r.baseLoad += change
-	r.baseLoad = clamp(r.baseLoad, 800, 2100)
-
-	// 2. Spike Logic
-	spikeVal := 0.0
-
-	if !r.isSpiking {
-		r.spikeTimer -= delta
-		if r.spikeTimer <= 0 {
-			r.isSpiking = true
-			r.spikeElapsed = 0
-			// Schedule next spike 10-15s after this one finishes
-			r.spikeTimer = 10.0 + rand.Float64()*5.0
-		}
-	} else {
-		r.spikeElapsed += delta
-		if r.spikeElapsed >= r.spikeDuration {
-			r.isSpiking = false
-		} else {
-			// Linear decay: magnitude * (1 - progress)
-			decayFactor := 1.0 - (r.spikeElapsed / r.spikeDuration)
-			spikeVal = r.spikeMagnitude * decayFactor
-		}
-	}
-
-	r.state.PowerLoad = math.Round(r.baseLoad + spikeVal)
+	r.state.PowerLoad = r.demand.step(r.rng, delta)
 }
 
 // Control helpers
@@ -267,6 +317,23 @@ func (r *Reactor) Snapshot() ReactorState {
 	return r.state
 }
 
+// SetSeed replaces the reactor's random source, used to reproduce a Scenario
+// run byte-for-byte.
+func (r *Reactor) SetSeed(seed int64) {
+	r.Lock()
+	defer r.Unlock()
+	r.rng = rand.New(rand.NewSource(seed))
+}
+
+// IsScenarioRunning reports whether a Scenario is currently driving this
+// reactor via Run, so callers (e.g. main's update loop) know not to call
+// Update themselves.
+func (r *Reactor) IsScenarioRunning() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.scenarioRunning
+}
+
 func (r *Reactor) Refuel() {
 	r.Lock()
 	defer r.Unlock()